@@ -0,0 +1,25 @@
+package cluster
+
+// BalancerMode selects the strategy used to pick a connection among the
+// cluster's online endpoints.
+type BalancerMode int
+
+const (
+	// BalancerModeRoundRobin distributes requests evenly across online
+	// endpoints. This is the default.
+	BalancerModeRoundRobin BalancerMode = iota
+	// BalancerModeRandom routes each request to a uniformly random online
+	// endpoint.
+	BalancerModeRandom
+	// BalancerModeLatency routes each request to the online endpoint with
+	// the lowest observed RPC latency. Ties are broken by LoadFactor.
+	BalancerModeLatency
+)
+
+// WithBalancerMode overrides the default round-robin balancer with the
+// strategy identified by mode.
+func WithBalancerMode(mode BalancerMode) Option {
+	return func(o *Options) {
+		o.BalancerMode = mode
+	}
+}
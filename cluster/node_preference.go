@@ -0,0 +1,33 @@
+package cluster
+
+import "context"
+
+// NodePreference scopes a single request to a subset of the cluster's
+// endpoints. It lets read-heavy call sites offload from primaries without
+// threading routing decisions through the query layer.
+type NodePreference int
+
+const (
+	// PreferLocalDC prefers endpoints whose Info.Local is true, falling
+	// back to any online endpoint if none are local.
+	PreferLocalDC NodePreference = iota + 1
+	// PreferFollower excludes leader endpoints unless none are available.
+	PreferFollower
+	// RequireLeader restricts routing to leader endpoints.
+	RequireLeader
+)
+
+type nodePreferenceContextKey struct{}
+
+// WithNodePreference returns a context that scopes the next Cluster.Get
+// call to endpoints matching pref.
+func WithNodePreference(ctx context.Context, pref NodePreference) context.Context {
+	return context.WithValue(ctx, nodePreferenceContextKey{}, pref)
+}
+
+// ContextNodePreference returns the NodePreference previously stored by
+// WithNodePreference, if any.
+func ContextNodePreference(ctx context.Context) (pref NodePreference, ok bool) {
+	pref, ok = ctx.Value(nodePreferenceContextKey{}).(NodePreference)
+	return pref, ok
+}
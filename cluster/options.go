@@ -0,0 +1,10 @@
+package cluster
+
+// Options holds the public, driver-wide cluster configuration assembled
+// from functional Options passed at construction time.
+type Options struct {
+	BalancerMode BalancerMode
+}
+
+// Option configures Options.
+type Option func(*Options)
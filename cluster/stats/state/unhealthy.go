@@ -0,0 +1,7 @@
+package state
+
+// Unhealthy marks an entry whose background health probe has failed
+// enough consecutive times to be pulled out of the balancer's rotation.
+// Unlike Banned, it is driven purely by probe results and clears itself
+// once probes succeed again, without waiting on discovery.
+const Unhealthy State = 100
@@ -0,0 +1,33 @@
+package balancer
+
+import (
+	"errors"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/info"
+)
+
+var (
+	// ErrNilBalancerElement is returned when an entry's Element handle is
+	// nil, e.g. because it was never inserted into a Balancer.
+	ErrNilBalancerElement = errors.New("balancer: nil element")
+
+	// ErrUnknownBalancerElement is returned when an Element handle is not
+	// (or is no longer) tracked by the Balancer it's presented to.
+	ErrUnknownBalancerElement = errors.New("balancer: unknown element")
+)
+
+// Element is an opaque handle returned by Balancer.Insert, used to refer
+// to a previously inserted connection in Remove, Update, and Contains.
+// Its concrete type is up to each Balancer implementation.
+type Element interface{}
+
+// Balancer selects a connection among the endpoints inserted into it,
+// according to a strategy of its own (round-robin, random, latency-aware).
+type Balancer interface {
+	Insert(c conn.Conn, i info.Info) Element
+	Remove(handle Element)
+	Update(handle Element, i info.Info)
+	Contains(handle Element) bool
+	Next() conn.Conn
+}
@@ -0,0 +1,163 @@
+package conn
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/endpoint"
+)
+
+// Dial opens a single gRPC connection to addr with the given dial options.
+type Dial func(ctx context.Context, addr string, opts ...grpc.DialOption) (*grpc.ClientConn, error)
+
+// Config configures how New dials and pools connections for a single
+// endpoint.
+type Config struct {
+	DialOptions []grpc.DialOption
+	// PoolSize is how many gRPC connections are dialed (lazily) per
+	// endpoint and round-robined on each use, working around gRPC's
+	// per-connection stream and head-of-line blocking limits under high
+	// concurrency. Values <= 1 keep a single connection, matching the
+	// pre-pool behavior.
+	PoolSize int
+	// MinIdle is how many pool members are warmed up (dialed eagerly) by
+	// New, instead of lazily on first use. It is clamped to PoolSize.
+	MinIdle int
+}
+
+// Conn is the driver's handle to a single cluster endpoint. It may be
+// backed by more than one underlying *grpc.ClientConn (see
+// Config.PoolSize); callers outside this package never see the pool
+// directly, only this single handle, so entry.Entry and the balancer
+// implementations are unaffected by pooling.
+type Conn interface {
+	Endpoint() endpoint.Endpoint
+	Runtime() *Runtime
+	// Invoke issues a unary RPC on one of the pool's connections, picked
+	// round-robin.
+	Invoke(ctx context.Context, method string, req, reply interface{}, opts ...grpc.CallOption) error
+	Close(ctx context.Context) error
+}
+
+type member struct {
+	mu sync.Mutex
+	cc *grpc.ClientConn
+}
+
+func (m *member) get(ctx context.Context, addr string, dial Dial, opts []grpc.DialOption) (*grpc.ClientConn, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if m.cc != nil {
+		return m.cc, nil
+	}
+	cc, err := dial(ctx, addr, opts...)
+	if err != nil {
+		return nil, err
+	}
+	m.cc = cc
+	return cc, nil
+}
+
+func (m *member) close() error {
+	m.mu.Lock()
+	cc := m.cc
+	m.cc = nil
+	m.mu.Unlock()
+	if cc == nil {
+		return nil
+	}
+	return cc.Close()
+}
+
+type conn struct {
+	ep       endpoint.Endpoint
+	dial     Dial
+	dialOpts []grpc.DialOption
+	rt       *Runtime
+
+	members []*member
+	next    uint64
+}
+
+// New creates a pool-backed Conn for ep. Config.MinIdle members are
+// pre-dialed here so a burst of early traffic doesn't pay dial latency;
+// the remaining pool members are dialed lazily on first use.
+func New(ctx context.Context, ep endpoint.Endpoint, dial Dial, cfg Config) Conn {
+	size := cfg.PoolSize
+	if size < 1 {
+		size = 1
+	}
+	minIdle := cfg.MinIdle
+	if minIdle > size {
+		minIdle = size
+	}
+
+	c := &conn{
+		ep:       ep,
+		dial:     dial,
+		dialOpts: cfg.DialOptions,
+		rt:       newRuntime(),
+		members:  make([]*member, size),
+	}
+	for i := range c.members {
+		c.members[i] = &member{}
+	}
+	for i := 0; i < minIdle; i++ {
+		// Best-effort warm-up: a failed pre-dial is retried lazily on
+		// first use, same as any other pool member.
+		_, _ = c.members[i].get(ctx, ep.Addr, c.dial, c.dialOpts)
+	}
+	return c
+}
+
+// pick returns a dialed pool member, round-robining across members and
+// dialing lazily on first use. If the preferred member fails to dial, the
+// remaining members are tried in order before giving up.
+func (c *conn) pick(ctx context.Context) (*grpc.ClientConn, error) {
+	n := len(c.members)
+	start := int(atomic.AddUint64(&c.next, 1) % uint64(n))
+
+	var firstErr error
+	for i := 0; i < n; i++ {
+		idx := (start + i) % n
+		cc, err := c.members[idx].get(ctx, c.ep.Addr, c.dial, c.dialOpts)
+		if err == nil {
+			return cc, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+	}
+	return nil, firstErr
+}
+
+func (c *conn) Endpoint() endpoint.Endpoint { return c.ep }
+
+func (c *conn) Runtime() *Runtime { return c.rt }
+
+func (c *conn) Invoke(ctx context.Context, method string, req, reply interface{}, opts ...grpc.CallOption) error {
+	cc, err := c.pick(ctx)
+	if err != nil {
+		return err
+	}
+	c.rt.opStarted()
+	defer c.rt.opDone()
+	return cc.Invoke(ctx, method, req, reply, opts...)
+}
+
+// Close closes every dialed pool member. State (Online/Banned/Unhealthy)
+// lives on the shared Runtime rather than per-member, so Pessimize and
+// the health checker already ban or unban the endpoint as a whole; Close
+// simply tears down whatever connections that whole happened to open.
+func (c *conn) Close(context.Context) error {
+	var firstErr error
+	for _, m := range c.members {
+		if err := m.close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
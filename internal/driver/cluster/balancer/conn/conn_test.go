@@ -0,0 +1,85 @@
+package conn
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/endpoint"
+)
+
+// realDial dials addr the same way the production Dial does, but with
+// insecure transport credentials and no blocking, so it's safe to use
+// from tests: it never touches the network, yet returns a genuine
+// *grpc.ClientConn (unlike a bare &grpc.ClientConn{}, whose Close()
+// panics on its nil internal cancel func).
+func realDial(_ context.Context, addr string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+	opts = append(opts, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	return grpc.Dial(addr, opts...)
+}
+
+func dialCounting(n *int32) Dial {
+	return func(ctx context.Context, addr string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
+		atomic.AddInt32(n, 1)
+		return realDial(ctx, addr, opts...)
+	}
+}
+
+func TestNewPreDialsMinIdleOnly(t *testing.T) {
+	var dials int32
+	New(context.Background(), endpoint.Endpoint{Addr: "localhost:1"}, dialCounting(&dials), Config{
+		PoolSize: 4,
+		MinIdle:  2,
+	})
+
+	if got := atomic.LoadInt32(&dials); got != 2 {
+		t.Fatalf("dials after New = %d, want MinIdle=2", got)
+	}
+}
+
+func TestNewClampsMinIdleToPoolSize(t *testing.T) {
+	var dials int32
+	New(context.Background(), endpoint.Endpoint{Addr: "localhost:1"}, dialCounting(&dials), Config{
+		PoolSize: 2,
+		MinIdle:  10,
+	})
+
+	if got := atomic.LoadInt32(&dials); got != 2 {
+		t.Fatalf("dials after New = %d, want clamped to PoolSize=2", got)
+	}
+}
+
+func TestPickRoundRobinsAcrossMembers(t *testing.T) {
+	c := New(context.Background(), endpoint.Endpoint{Addr: "localhost:1"}, realDial, Config{PoolSize: 3}).(*conn)
+
+	seen := make(map[*grpc.ClientConn]struct{})
+	for i := 0; i < 3; i++ {
+		cc, err := c.pick(context.Background())
+		if err != nil {
+			t.Fatalf("pick() error: %v", err)
+		}
+		seen[cc] = struct{}{}
+	}
+	if len(seen) != 3 {
+		t.Fatalf("pick() visited %d distinct members, want 3", len(seen))
+	}
+}
+
+func TestCloseClosesEveryMember(t *testing.T) {
+	c := New(context.Background(), endpoint.Endpoint{Addr: "localhost:1"}, dialCounting(new(int32)), Config{
+		PoolSize: 2,
+		MinIdle:  2,
+	}).(*conn)
+
+	if err := c.Close(context.Background()); err != nil {
+		t.Fatalf("Close() error: %v", err)
+	}
+	for i, m := range c.members {
+		if m.cc != nil {
+			t.Fatalf("member %d still holds a connection after Close()", i)
+		}
+	}
+}
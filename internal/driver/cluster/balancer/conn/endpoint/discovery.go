@@ -0,0 +1,25 @@
+package endpoint
+
+// DiscoveryInfo carries the per-endpoint fields reported by a single
+// entry of a ListEndpoints discovery response.
+type DiscoveryInfo struct {
+	ID         uint32
+	Addr       string
+	LoadFactor float32
+	Local      bool
+	// Role is the raw role string as reported by discovery (e.g.
+	// "leader"/"follower"), mapped to a Role by FromDiscovery.
+	Role string
+}
+
+// FromDiscovery builds the Endpoint for a single ListEndpoints entry,
+// populating Role via RoleFromDiscovery.
+func FromDiscovery(di DiscoveryInfo) Endpoint {
+	return Endpoint{
+		ID:         di.ID,
+		Addr:       di.Addr,
+		LoadFactor: di.LoadFactor,
+		Local:      di.Local,
+		Role:       RoleFromDiscovery(di.Role),
+	}
+}
@@ -0,0 +1,29 @@
+package endpoint
+
+import "fmt"
+
+// NodeID uniquely identifies an endpoint within a cluster.
+type NodeID uint32
+
+// Endpoint describes a single cluster member, as reported by discovery.
+type Endpoint struct {
+	ID         uint32
+	Addr       string
+	LoadFactor float32
+	Local      bool
+	// Role is the endpoint's position in the database's Raft group,
+	// populated from the discovery response via RoleFromDiscovery. It
+	// defaults to RoleUnknown for clusters whose discovery response
+	// doesn't report a role.
+	Role Role
+}
+
+// NodeID returns the identifier used to index this endpoint in the
+// cluster.
+func (e Endpoint) NodeID() NodeID {
+	return NodeID(e.ID)
+}
+
+func (e Endpoint) String() string {
+	return fmt.Sprintf("%s:%d", e.Addr, e.ID)
+}
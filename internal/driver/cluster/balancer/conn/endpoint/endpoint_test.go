@@ -0,0 +1,36 @@
+package endpoint
+
+import "testing"
+
+func TestEndpointNodeID(t *testing.T) {
+	e := Endpoint{ID: 42}
+	if got := e.NodeID(); got != NodeID(42) {
+		t.Fatalf("NodeID() = %d, want 42", got)
+	}
+}
+
+func TestRoleFromDiscovery(t *testing.T) {
+	cases := []struct {
+		raw  string
+		want Role
+	}{
+		{"leader", RoleLeader},
+		{"follower", RoleFollower},
+		{"", RoleUnknown},
+		{"candidate", RoleUnknown},
+	}
+	for _, c := range cases {
+		if got := RoleFromDiscovery(c.raw); got != c.want {
+			t.Errorf("RoleFromDiscovery(%q) = %v, want %v", c.raw, got, c.want)
+		}
+	}
+}
+
+func TestFromDiscoveryPopulatesRole(t *testing.T) {
+	e := FromDiscovery(DiscoveryInfo{ID: 7, Addr: "host:2135", LoadFactor: 0.5, Local: true, Role: "leader"})
+
+	want := Endpoint{ID: 7, Addr: "host:2135", LoadFactor: 0.5, Local: true, Role: RoleLeader}
+	if e != want {
+		t.Fatalf("FromDiscovery(...) = %+v, want %+v", e, want)
+	}
+}
@@ -0,0 +1,30 @@
+package endpoint
+
+// Role describes an endpoint's position in the database's Raft group, as
+// reported by discovery. It lets the cluster honor a caller's node
+// preference (e.g. routing reads to followers) without guessing from
+// load factor alone.
+type Role int
+
+const (
+	// RoleUnknown is used for endpoints whose role discovery did not
+	// report, e.g. older clusters.
+	RoleUnknown Role = iota
+	RoleLeader
+	RoleFollower
+)
+
+// RoleFromDiscovery maps the role string reported by a ListEndpoints
+// discovery response to a Role. Anything other than "leader"/"follower",
+// including an empty string from older clusters that don't report a role
+// at all, maps to RoleUnknown.
+func RoleFromDiscovery(raw string) Role {
+	switch raw {
+	case "leader":
+		return RoleLeader
+	case "follower":
+		return RoleFollower
+	default:
+		return RoleUnknown
+	}
+}
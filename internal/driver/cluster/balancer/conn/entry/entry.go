@@ -0,0 +1,34 @@
+package entry
+
+import (
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/info"
+)
+
+// Entry is a single cluster member as tracked by cluster.index: its
+// connection, the Info last reported for it by discovery, and its handle
+// in the cluster's active Balancer.
+type Entry struct {
+	Conn   conn.Conn
+	Info   info.Info
+	Handle balancer.Element
+}
+
+// InsertInto registers e's connection into bal and records the returned
+// handle. It is a no-op if e has no connection yet.
+func (e *Entry) InsertInto(bal balancer.Balancer) {
+	if e.Conn == nil {
+		return
+	}
+	e.Handle = bal.Insert(e.Conn, e.Info)
+}
+
+// RemoveFrom unregisters e from bal using its handle. It is a no-op if e
+// was never inserted.
+func (e *Entry) RemoveFrom(bal balancer.Balancer) {
+	if e.Handle == nil {
+		return
+	}
+	bal.Remove(e.Handle)
+}
@@ -0,0 +1,9 @@
+package info
+
+// Info carries the per-endpoint data reported by discovery that Balancer
+// implementations use for routing decisions, such as the latency
+// balancer's LoadFactor tiebreak.
+type Info struct {
+	LoadFactor float32
+	Local      bool
+}
@@ -0,0 +1,59 @@
+package conn
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/cluster/stats/state"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/endpoint"
+)
+
+// Runtime holds the mutable, concurrently-accessed state of a Conn. State
+// is intentionally pool-wide rather than per-member: a Conn backed by
+// several gRPC connections is still a single logical endpoint, so
+// Pessimize and the health checker ban or unban it as a whole rather than
+// connection by connection.
+type Runtime struct {
+	mu    sync.RWMutex
+	state state.State
+
+	opsInFlight int64
+}
+
+// NewRuntime creates a Runtime starting in state.Online.
+func NewRuntime() *Runtime {
+	return newRuntime()
+}
+
+func newRuntime() *Runtime {
+	return &Runtime{state: state.Online}
+}
+
+// GetState returns the Conn's current state.
+func (r *Runtime) GetState() state.State {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.state
+}
+
+// SetState transitions the Conn to s.
+func (r *Runtime) SetState(_ context.Context, _ endpoint.Endpoint, s state.State) {
+	r.mu.Lock()
+	r.state = s
+	r.mu.Unlock()
+}
+
+func (r *Runtime) opStarted() {
+	atomic.AddInt64(&r.opsInFlight, 1)
+}
+
+func (r *Runtime) opDone() {
+	atomic.AddInt64(&r.opsInFlight, -1)
+}
+
+// OpsInFlight returns the number of in-flight RPCs aggregated across
+// every connection in the pool.
+func (r *Runtime) OpsInFlight() int64 {
+	return atomic.LoadInt64(&r.opsInFlight)
+}
@@ -0,0 +1,37 @@
+package conn
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/cluster/stats/state"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/endpoint"
+)
+
+func TestRuntimeStartsOnline(t *testing.T) {
+	rt := NewRuntime()
+	if got := rt.GetState(); got != state.Online {
+		t.Fatalf("GetState() = %v, want Online", got)
+	}
+}
+
+func TestRuntimeOpsInFlight(t *testing.T) {
+	rt := NewRuntime()
+	rt.opStarted()
+	rt.opStarted()
+	if got := rt.OpsInFlight(); got != 2 {
+		t.Fatalf("OpsInFlight() = %d, want 2", got)
+	}
+	rt.opDone()
+	if got := rt.OpsInFlight(); got != 1 {
+		t.Fatalf("OpsInFlight() = %d, want 1", got)
+	}
+}
+
+func TestRuntimeSetState(t *testing.T) {
+	rt := NewRuntime()
+	rt.SetState(context.Background(), endpoint.Endpoint{}, state.Banned)
+	if got := rt.GetState(); got != state.Banned {
+		t.Fatalf("GetState() = %v, want Banned", got)
+	}
+}
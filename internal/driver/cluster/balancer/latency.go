@@ -0,0 +1,312 @@
+package balancer
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/cluster/stats/state"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/info"
+)
+
+// latencyAlpha is the EWMA smoothing factor for the latency balancer: a
+// higher value weighs recent RPCs more heavily at the cost of a noisier
+// estimate.
+const latencyAlpha = 0.2
+
+// latencyColdPenalty inflates the EWMA of an endpoint that received no
+// traffic during a decay tick, so a node that looks slow from a stale
+// measurement eventually gets tried again instead of starving forever.
+const latencyColdPenalty = 1.05
+
+type latencyItem struct {
+	conn conn.Conn
+	info info.Info
+
+	mu      sync.Mutex
+	ewma    time.Duration
+	primed  bool
+	touched bool
+}
+
+// latencyBalancer is a Balancer that routes to the endpoint with the
+// lowest observed RPC latency. Ties are broken by Info.LoadFactor.
+type latencyBalancer struct {
+	mu    sync.RWMutex
+	items map[endpoint.NodeID]*latencyItem
+
+	done     chan struct{}
+	stopOnce sync.Once
+}
+
+// NewLatency creates a latency-aware Balancer. repeatInterval should match
+// the cluster's discovery interval: every tick the balancer decays the
+// EWMA of endpoints that saw no traffic, so cold nodes eventually re-enter
+// rotation instead of being starved forever by one bad measurement.
+func NewLatency(repeatInterval time.Duration) Balancer {
+	b := &latencyBalancer{
+		items: make(map[endpoint.NodeID]*latencyItem),
+		done:  make(chan struct{}),
+	}
+	if repeatInterval > 0 {
+		go b.decayLoop(repeatInterval)
+	}
+	return b
+}
+
+// Stop terminates the decay goroutine started by NewLatency when
+// repeatInterval > 0. It is safe to call more than once and safe to call
+// even if no decay goroutine was started. Callers (cluster.Close) must
+// invoke it or the goroutine and its ticker leak for the process
+// lifetime.
+func (b *latencyBalancer) Stop() {
+	b.stopOnce.Do(func() {
+		close(b.done)
+	})
+}
+
+func (b *latencyBalancer) decayLoop(repeatInterval time.Duration) {
+	ticker := time.NewTicker(repeatInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			b.decay()
+		case <-b.done:
+			return
+		}
+	}
+}
+
+func (b *latencyBalancer) decay() {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, it := range b.items {
+		it.mu.Lock()
+		if !it.touched {
+			it.ewma = time.Duration(float64(it.ewma) * latencyColdPenalty)
+		}
+		it.touched = false
+		it.mu.Unlock()
+	}
+}
+
+// Insert registers c with the balancer, keyed by its endpoint's NodeID.
+func (b *latencyBalancer) Insert(c conn.Conn, i info.Info) Element {
+	nodeID := c.Endpoint().NodeID()
+	it := &latencyItem{conn: c, info: i}
+
+	b.mu.Lock()
+	b.items[nodeID] = it
+	b.mu.Unlock()
+
+	return nodeID
+}
+
+// Remove drops the endpoint identified by handle from the balancer.
+func (b *latencyBalancer) Remove(handle Element) {
+	nodeID, ok := handle.(endpoint.NodeID)
+	if !ok {
+		return
+	}
+	b.mu.Lock()
+	delete(b.items, nodeID)
+	b.mu.Unlock()
+}
+
+// Update refreshes the Info (LoadFactor, Local) associated with handle.
+func (b *latencyBalancer) Update(handle Element, i info.Info) {
+	nodeID, ok := handle.(endpoint.NodeID)
+	if !ok {
+		return
+	}
+	b.mu.RLock()
+	it, has := b.items[nodeID]
+	b.mu.RUnlock()
+	if !has {
+		return
+	}
+	it.mu.Lock()
+	it.info = i
+	it.mu.Unlock()
+}
+
+// Contains reports whether handle is currently tracked by the balancer.
+func (b *latencyBalancer) Contains(handle Element) bool {
+	nodeID, ok := handle.(endpoint.NodeID)
+	if !ok {
+		return false
+	}
+	b.mu.RLock()
+	_, has := b.items[nodeID]
+	b.mu.RUnlock()
+	return has
+}
+
+// Next returns the online connection with the lowest observed EWMA
+// latency, falling back to LoadFactor on ties.
+func (b *latencyBalancer) Next() conn.Conn {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	var best *latencyItem
+	var bestEWMA time.Duration
+
+	for _, it := range b.items {
+		if it.conn.Runtime().GetState() != state.Online {
+			continue
+		}
+		it.mu.Lock()
+		ewma, primed, lf := it.ewma, it.primed, it.info.LoadFactor
+		it.mu.Unlock()
+		if !primed {
+			// Never-measured endpoints are tried first so they get a
+			// chance to establish a baseline.
+			return it.conn
+		}
+		switch {
+		case best == nil, ewma < bestEWMA:
+			best, bestEWMA = it, ewma
+		case ewma == bestEWMA && lf < best.info.LoadFactor:
+			best = it
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	return best.conn
+}
+
+// Candidates returns every online connection currently tracked by the
+// balancer, for callers (such as request-scoped node preference routing)
+// that need to filter the full set rather than take the single best pick.
+func (b *latencyBalancer) Candidates() []conn.Conn {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	conns := make([]conn.Conn, 0, len(b.items))
+	for _, it := range b.items {
+		if it.conn.Runtime().GetState() == state.Online {
+			conns = append(conns, it.conn)
+		}
+	}
+	return conns
+}
+
+// InterceptorFor returns a grpc.UnaryClientInterceptor that feeds observed
+// RPC durations for nodeID into the balancer's EWMA. It is meant to be
+// installed once, at dial time, on the connection opened for nodeID.
+func (b *latencyBalancer) InterceptorFor(nodeID endpoint.NodeID) grpc.UnaryClientInterceptor {
+	return func(
+		ctx context.Context,
+		method string,
+		req, reply interface{},
+		cc *grpc.ClientConn,
+		invoker grpc.UnaryInvoker,
+		opts ...grpc.CallOption,
+	) error {
+		start := time.Now()
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		b.record(nodeID, time.Since(start))
+		return err
+	}
+}
+
+func (b *latencyBalancer) record(nodeID endpoint.NodeID, d time.Duration) {
+	b.mu.RLock()
+	it, has := b.items[nodeID]
+	b.mu.RUnlock()
+	if !has {
+		return
+	}
+	it.mu.Lock()
+	defer it.mu.Unlock()
+	it.touched = true
+	if !it.primed {
+		it.ewma = d
+		it.primed = true
+		return
+	}
+	it.ewma = time.Duration(latencyAlpha*float64(d) + (1-latencyAlpha)*float64(it.ewma))
+}
+
+// randomBalancer is a Balancer that picks uniformly at random among the
+// online endpoints. It trades the latency balancer's bias towards fast
+// nodes for a simpler, stateless distribution.
+type randomBalancer struct {
+	mu    sync.RWMutex
+	conns map[endpoint.NodeID]conn.Conn
+}
+
+// NewRandom creates a Balancer that routes requests to a uniformly random
+// online endpoint.
+func NewRandom() Balancer {
+	return &randomBalancer{conns: make(map[endpoint.NodeID]conn.Conn)}
+}
+
+func (b *randomBalancer) Insert(c conn.Conn, _ info.Info) Element {
+	nodeID := c.Endpoint().NodeID()
+	b.mu.Lock()
+	b.conns[nodeID] = c
+	b.mu.Unlock()
+	return nodeID
+}
+
+func (b *randomBalancer) Remove(handle Element) {
+	nodeID, ok := handle.(endpoint.NodeID)
+	if !ok {
+		return
+	}
+	b.mu.Lock()
+	delete(b.conns, nodeID)
+	b.mu.Unlock()
+}
+
+func (b *randomBalancer) Update(Element, info.Info) {}
+
+func (b *randomBalancer) Contains(handle Element) bool {
+	nodeID, ok := handle.(endpoint.NodeID)
+	if !ok {
+		return false
+	}
+	b.mu.RLock()
+	_, has := b.conns[nodeID]
+	b.mu.RUnlock()
+	return has
+}
+
+// Candidates returns every online connection currently tracked by the
+// balancer.
+func (b *randomBalancer) Candidates() []conn.Conn {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	conns := make([]conn.Conn, 0, len(b.conns))
+	for _, c := range b.conns {
+		if c.Runtime().GetState() == state.Online {
+			conns = append(conns, c)
+		}
+	}
+	return conns
+}
+
+func (b *randomBalancer) Next() conn.Conn {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	online := make([]conn.Conn, 0, len(b.conns))
+	for _, c := range b.conns {
+		if c.Runtime().GetState() == state.Online {
+			online = append(online, c)
+		}
+	}
+	if len(online) == 0 {
+		return nil
+	}
+	return online[rand.Intn(len(online))]
+}
@@ -0,0 +1,131 @@
+package balancer
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/cluster/stats/state"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/info"
+)
+
+type fakeConn struct {
+	ep endpoint.Endpoint
+	rt *conn.Runtime
+}
+
+func newFakeConn(nodeID uint32) *fakeConn {
+	return &fakeConn{
+		ep: endpoint.Endpoint{ID: nodeID},
+		rt: conn.NewRuntime(),
+	}
+}
+
+func (c *fakeConn) Endpoint() endpoint.Endpoint { return c.ep }
+func (c *fakeConn) Runtime() *conn.Runtime      { return c.rt }
+func (c *fakeConn) Invoke(context.Context, string, interface{}, interface{}, ...grpc.CallOption) error {
+	return nil
+}
+func (c *fakeConn) Close(context.Context) error { return nil }
+
+var _ conn.Conn = (*fakeConn)(nil)
+
+func TestLatencyBalancerPrefersLowerEWMA(t *testing.T) {
+	b := NewLatency(0).(*latencyBalancer)
+
+	fast := newFakeConn(1)
+	slow := newFakeConn(2)
+
+	fastHandle := b.Insert(fast, info.Info{})
+	slowHandle := b.Insert(slow, info.Info{})
+
+	// Prime both so Next() stops preferring them for being "never measured".
+	b.record(fastHandle.(endpoint.NodeID), 10*time.Millisecond)
+	b.record(slowHandle.(endpoint.NodeID), 100*time.Millisecond)
+
+	if got := b.Next(); got != fast {
+		t.Fatalf("Next() = %v, want the fast conn", got.Endpoint())
+	}
+}
+
+func TestLatencyBalancerTiesBreakByLoadFactor(t *testing.T) {
+	b := NewLatency(0).(*latencyBalancer)
+
+	lightlyLoaded := newFakeConn(1)
+	heavilyLoaded := newFakeConn(2)
+
+	lightHandle := b.Insert(lightlyLoaded, info.Info{LoadFactor: 0.1})
+	heavyHandle := b.Insert(heavilyLoaded, info.Info{LoadFactor: 0.9})
+
+	b.record(lightHandle.(endpoint.NodeID), 50*time.Millisecond)
+	b.record(heavyHandle.(endpoint.NodeID), 50*time.Millisecond)
+
+	if got := b.Next(); got != lightlyLoaded {
+		t.Fatalf("Next() = %v, want the lower LoadFactor conn on a latency tie", got.Endpoint())
+	}
+}
+
+func TestLatencyBalancerSkipsOfflineConns(t *testing.T) {
+	b := NewLatency(0).(*latencyBalancer)
+
+	c := newFakeConn(1)
+	handle := b.Insert(c, info.Info{})
+	b.record(handle.(endpoint.NodeID), time.Millisecond)
+
+	c.rt.SetState(context.Background(), c.ep, state.Banned)
+
+	if got := b.Next(); got != nil {
+		t.Fatalf("Next() = %v, want nil for an endpoint with no online conns", got)
+	}
+}
+
+func TestLatencyBalancerDecayRecoversColdNode(t *testing.T) {
+	b := NewLatency(0).(*latencyBalancer)
+
+	c := newFakeConn(1)
+	nodeID := b.Insert(c, info.Info{}).(endpoint.NodeID)
+	b.record(nodeID, 100*time.Millisecond)
+
+	// record() leaves the item "touched", so a decay() right after it is a
+	// no-op on the EWMA (it only clears touched). Clear it directly here
+	// to simulate two consecutive ticks with no traffic in between, which
+	// is the case decay() is actually meant to penalize.
+	b.mu.RLock()
+	item := b.items[nodeID]
+	b.mu.RUnlock()
+	item.mu.Lock()
+	item.touched = false
+	item.mu.Unlock()
+
+	b.decay()
+	b.decay()
+
+	item.mu.Lock()
+	ewma := item.ewma
+	item.mu.Unlock()
+
+	if want := time.Duration(float64(100*time.Millisecond) * latencyColdPenalty * latencyColdPenalty); ewma != want {
+		t.Fatalf("ewma after two decays = %v, want %v", ewma, want)
+	}
+}
+
+func TestRandomBalancerOnlyReturnsOnlineConns(t *testing.T) {
+	b := NewRandom().(*randomBalancer)
+
+	online := newFakeConn(1)
+	banned := newFakeConn(2)
+	banned.rt.SetState(context.Background(), banned.ep, state.Banned)
+
+	b.Insert(online, info.Info{})
+	b.Insert(banned, info.Info{})
+
+	for i := 0; i < 20; i++ {
+		if got := b.Next(); got != online {
+			t.Fatalf("Next() = %v, want the only online conn", got)
+		}
+	}
+}
@@ -0,0 +1,100 @@
+package balancer
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/cluster/stats/state"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/info"
+)
+
+// roundRobinBalancer is a Balancer that distributes requests evenly
+// across the online endpoints, cycling through them in insertion order.
+type roundRobinBalancer struct {
+	mu    sync.RWMutex
+	conns map[endpoint.NodeID]conn.Conn
+	order []endpoint.NodeID
+
+	next uint64
+}
+
+// NewRoundRobin creates a Balancer that cycles through online endpoints
+// in insertion order. This is the default balancer mode.
+func NewRoundRobin() Balancer {
+	return &roundRobinBalancer{conns: make(map[endpoint.NodeID]conn.Conn)}
+}
+
+func (b *roundRobinBalancer) Insert(c conn.Conn, _ info.Info) Element {
+	nodeID := c.Endpoint().NodeID()
+	b.mu.Lock()
+	b.conns[nodeID] = c
+	b.order = append(b.order, nodeID)
+	b.mu.Unlock()
+	return nodeID
+}
+
+func (b *roundRobinBalancer) Remove(handle Element) {
+	nodeID, ok := handle.(endpoint.NodeID)
+	if !ok {
+		return
+	}
+	b.mu.Lock()
+	delete(b.conns, nodeID)
+	for i, id := range b.order {
+		if id == nodeID {
+			b.order = append(b.order[:i], b.order[i+1:]...)
+			break
+		}
+	}
+	b.mu.Unlock()
+}
+
+func (b *roundRobinBalancer) Update(Element, info.Info) {}
+
+func (b *roundRobinBalancer) Contains(handle Element) bool {
+	nodeID, ok := handle.(endpoint.NodeID)
+	if !ok {
+		return false
+	}
+	b.mu.RLock()
+	_, has := b.conns[nodeID]
+	b.mu.RUnlock()
+	return has
+}
+
+// Candidates returns every online connection currently tracked by the
+// balancer, in insertion order.
+func (b *roundRobinBalancer) Candidates() []conn.Conn {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	conns := make([]conn.Conn, 0, len(b.order))
+	for _, nodeID := range b.order {
+		if c := b.conns[nodeID]; c != nil && c.Runtime().GetState() == state.Online {
+			conns = append(conns, c)
+		}
+	}
+	return conns
+}
+
+// Next returns the next online connection in insertion order, starting
+// from wherever the previous call left off.
+func (b *roundRobinBalancer) Next() conn.Conn {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	n := len(b.order)
+	if n == 0 {
+		return nil
+	}
+	start := int(atomic.AddUint64(&b.next, 1) % uint64(n))
+	for i := 0; i < n; i++ {
+		c := b.conns[b.order[(start+i)%n]]
+		if c != nil && c.Runtime().GetState() == state.Online {
+			return c
+		}
+	}
+	return nil
+}
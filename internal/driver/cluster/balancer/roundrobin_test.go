@@ -0,0 +1,52 @@
+package balancer
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/cluster/stats/state"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/info"
+)
+
+func TestRoundRobinCyclesInInsertionOrder(t *testing.T) {
+	b := NewRoundRobin().(*roundRobinBalancer)
+
+	a := newFakeConn(1)
+	c := newFakeConn(2)
+	b.Insert(a, info.Info{})
+	b.Insert(c, info.Info{})
+
+	first := b.Next()
+	second := b.Next()
+	third := b.Next()
+	if first == second {
+		t.Fatalf("Next() returned the same conn twice in a row: %v", first.Endpoint())
+	}
+	if first != third {
+		t.Fatalf("Next() did not cycle back to the first conn on the third call")
+	}
+}
+
+func TestRoundRobinSkipsOfflineConns(t *testing.T) {
+	b := NewRoundRobin().(*roundRobinBalancer)
+
+	online := newFakeConn(1)
+	banned := newFakeConn(2)
+	banned.rt.SetState(context.Background(), banned.ep, state.Banned)
+
+	b.Insert(online, info.Info{})
+	b.Insert(banned, info.Info{})
+
+	for i := 0; i < 5; i++ {
+		if got := b.Next(); got != online {
+			t.Fatalf("Next() = %v, want the only online conn", got)
+		}
+	}
+}
+
+func TestRoundRobinNextOnEmptyReturnsNil(t *testing.T) {
+	b := NewRoundRobin()
+	if got := b.Next(); got != nil {
+		t.Fatalf("Next() on an empty balancer = %v, want nil", got)
+	}
+}
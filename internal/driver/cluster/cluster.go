@@ -38,15 +38,24 @@ var (
 	ErrUnknownEndpoint = errors.New("unknown endpoint")
 )
 
+// latencyInterceptorBalancer is implemented by balancer.Balancer
+// strategies that need to observe per-RPC latency for the connection
+// opened for a given node (currently only the latency-aware balancer).
+type latencyInterceptorBalancer interface {
+	InterceptorFor(endpoint.NodeID) grpc.UnaryClientInterceptor
+}
+
 type cluster struct {
 	trace    trace.Driver
-	dial     func(context.Context, string) (*grpc.ClientConn, error)
+	dial     func(context.Context, string, ...grpc.DialOption) (*grpc.ClientConn, error)
 	balancer balancer.Balancer
 	explorer repeater.Repeater
 
-	index map[endpoint.NodeID]entry.Entry
-	ready int
-	wait  chan struct{}
+	index          map[endpoint.NodeID]entry.Entry
+	healthCheckers map[endpoint.NodeID]*healthChecker
+	events         *eventBus
+	ready          int
+	wait           chan struct{}
 
 	mu     sync.RWMutex
 	closed bool
@@ -69,18 +78,43 @@ type Cluster interface {
 	Remove(ctx context.Context, endpoint endpoint.Endpoint, wg ...option)
 	SetExplorer(repeater repeater.Repeater)
 	Force()
+	Subscribe(ch chan<- Event) (unsubscribe func())
 }
 
+// New constructs a Cluster. repeatInterval paces the latency balancer's
+// cold-node decay (see public.BalancerModeLatency) and should match the
+// cluster's discovery interval; it has no effect for other balancer modes.
 func New(
 	trace trace.Driver,
-	dial func(context.Context, string) (*grpc.ClientConn, error),
-	balancer balancer.Balancer,
+	dial func(context.Context, string, ...grpc.DialOption) (*grpc.ClientConn, error),
+	repeatInterval time.Duration,
+	opts ...public.Option,
 ) Cluster {
+	options := public.Options{}
+	for _, o := range opts {
+		o(&options)
+	}
 	return &cluster{
-		trace:    trace,
-		index:    make(map[endpoint.NodeID]entry.Entry),
-		dial:     dial,
-		balancer: balancer,
+		trace:          trace,
+		index:          make(map[endpoint.NodeID]entry.Entry),
+		healthCheckers: make(map[endpoint.NodeID]*healthChecker),
+		events:         newEventBus(),
+		dial:           dial,
+		balancer:       balancerForMode(options.BalancerMode, repeatInterval),
+	}
+}
+
+// balancerForMode constructs the balancer.Balancer implementation selected
+// by mode. repeatInterval is only used by public.BalancerModeLatency, to
+// pace decay of cold endpoints.
+func balancerForMode(mode public.BalancerMode, repeatInterval time.Duration) balancer.Balancer {
+	switch mode {
+	case public.BalancerModeRandom:
+		return balancer.NewRandom()
+	case public.BalancerModeLatency:
+		return balancer.NewLatency(repeatInterval)
+	default:
+		return balancer.NewRoundRobin()
 	}
 }
 
@@ -101,11 +135,20 @@ func (c *cluster) Close(ctx context.Context) (err error) {
 	index := c.index
 	c.index = nil
 
+	healthCheckers := c.healthCheckers
+	c.healthCheckers = nil
+
 	c.mu.Unlock()
 
 	if wait != nil {
 		close(wait)
 	}
+	if stopper, ok := c.balancer.(interface{ Stop() }); ok {
+		stopper.Stop()
+	}
+	for _, hc := range healthCheckers {
+		hc.Stop()
+	}
 	for _, entry := range index {
 		if entry.Conn != nil {
 			_ = entry.Conn.Close(ctx)
@@ -131,6 +174,19 @@ func (c *cluster) Get(ctx context.Context) (conn conn.Conn, err error) {
 		}
 	}
 
+	if pref, ok := public.ContextNodePreference(ctx); ok {
+		if candidates, has := c.balancer.(candidatesBalancer); has {
+			if picked := pickByPreference(candidates.Candidates(), pref); picked != nil {
+				onDone(picked.Endpoint(), nil)
+				return picked, nil
+			}
+			if pref == public.RequireLeader {
+				onDone(endpoint.Endpoint{}, ErrClusterEmpty)
+				return nil, ErrClusterEmpty
+			}
+		}
+	}
+
 	conn = c.balancer.Next()
 	if conn == nil {
 		err = ErrClusterEmpty
@@ -139,9 +195,50 @@ func (c *cluster) Get(ctx context.Context) (conn conn.Conn, err error) {
 	return conn, err
 }
 
+// candidatesBalancer is implemented by balancer.Balancer strategies that
+// can enumerate their online connections, so that cluster.Get can filter
+// them by a request-scoped public.NodePreference before falling back to
+// the balancer's own pick.
+type candidatesBalancer interface {
+	Candidates() []conn.Conn
+}
+
+// pickByPreference returns the first candidate matching pref. RequireLeader
+// returns nil if no candidate is a leader; PreferLocalDC and PreferFollower
+// degrade gracefully to the first candidate when none satisfies the
+// preference. It returns nil for an empty candidate set.
+func pickByPreference(candidates []conn.Conn, pref public.NodePreference) conn.Conn {
+	switch pref {
+	case public.RequireLeader:
+		for _, c := range candidates {
+			if c.Endpoint().Role == endpoint.RoleLeader {
+				return c
+			}
+		}
+		return nil
+	case public.PreferFollower:
+		for _, c := range candidates {
+			if c.Endpoint().Role != endpoint.RoleLeader {
+				return c
+			}
+		}
+	case public.PreferLocalDC:
+		for _, c := range candidates {
+			if c.Endpoint().Local {
+				return c
+			}
+		}
+	}
+	if len(candidates) == 0 {
+		return nil
+	}
+	return candidates[0]
+}
+
 type optionsHolder struct {
-	wg         wg.WG
-	connConfig conn.Config
+	wg          wg.WG
+	connConfig  conn.Config
+	healthCheck HealthCheckConfig
 }
 
 type option func(options *optionsHolder)
@@ -158,6 +255,27 @@ func WithConnConfig(connConfig conn.Config) option {
 	}
 }
 
+// WithConnPoolSize sets how many gRPC connections are dialed (lazily) per
+// endpoint, round-robined on each use. This works around gRPC's
+// per-connection stream and head-of-line blocking limits under high
+// concurrency, at the cost of one extra TCP connection and handshake per
+// additional pool member. n <= 1 keeps the previous single-connection
+// behavior.
+func WithConnPoolSize(n int) option {
+	return func(options *optionsHolder) {
+		options.connConfig.PoolSize = n
+	}
+}
+
+// WithConnMinIdle sets how many connections in the endpoint's pool are
+// warmed up (pre-dialed) on Insert, instead of being dialed lazily on
+// first use. m is clamped to PoolSize by the conn package.
+func WithConnMinIdle(m int) option {
+	return func(options *optionsHolder) {
+		options.connConfig.MinIdle = m
+	}
+}
+
 // Insert inserts new connection into the cluster.
 func (c *cluster) Insert(ctx context.Context, endpoint endpoint.Endpoint, opts ...option) {
 	holder := optionsHolder{}
@@ -168,11 +286,18 @@ func (c *cluster) Insert(ctx context.Context, endpoint endpoint.Endpoint, opts .
 		defer holder.wg.Done()
 	}
 
+	connConfig := holder.connConfig
+	if withInterceptor, ok := c.balancer.(latencyInterceptorBalancer); ok {
+		connConfig.DialOptions = append(connConfig.DialOptions, grpc.WithUnaryInterceptor(
+			withInterceptor.InterceptorFor(endpoint.NodeID()),
+		))
+	}
+
 	conn := conn.New(
 		ctx,
 		endpoint,
 		c.dial,
-		holder.connConfig,
+		connConfig,
 	)
 
 	c.mu.Lock()
@@ -204,6 +329,13 @@ func (c *cluster) Insert(ctx context.Context, endpoint endpoint.Endpoint, opts .
 	wait = c.wait
 	c.wait = nil
 	c.index[endpoint.NodeID()] = entry
+	c.emit(Event{Kind: EventAdded, Endpoint: endpoint, NewState: entry.Conn.Runtime().GetState()})
+
+	if holder.healthCheck.Probe != nil {
+		c.healthCheckers[endpoint.NodeID()] = startHealthChecker(c.trace, conn, holder.healthCheck, func(old, next state.State) {
+			c.emit(Event{Kind: EventStateChanged, Endpoint: endpoint, OldState: old, NewState: next})
+		})
+	}
 }
 
 // Update updates existing connection's runtime stats such that load factor and others.
@@ -235,6 +367,7 @@ func (c *cluster) Update(ctx context.Context, endpoint endpoint.Endpoint, opts .
 		onDone(entry.Conn.Runtime().GetState())
 	}()
 
+	oldState := entry.Conn.Runtime().GetState()
 	entry.Info = info.Info{LoadFactor: endpoint.LoadFactor, Local: endpoint.Local}
 	entry.Conn.Runtime().SetState(ctx, endpoint, state.Online)
 	c.index[endpoint.NodeID()] = entry
@@ -242,6 +375,7 @@ func (c *cluster) Update(ctx context.Context, endpoint endpoint.Endpoint, opts .
 		// entry.Handle may be nil when connection is being tracked.
 		c.balancer.Update(entry.Handle, entry.Info)
 	}
+	c.emit(Event{Kind: EventUpdated, Endpoint: endpoint, OldState: oldState, NewState: state.Online})
 }
 
 // Remove removes and closes previously inserted connection.
@@ -271,12 +405,18 @@ func (c *cluster) Remove(ctx context.Context, endpoint endpoint.Endpoint, opts .
 	entry.RemoveFrom(c.balancer)
 	c.ready--
 	delete(c.index, endpoint.NodeID())
+	hc, hasHealthChecker := c.healthCheckers[endpoint.NodeID()]
+	delete(c.healthCheckers, endpoint.NodeID())
 	c.mu.Unlock()
 
+	if hasHealthChecker {
+		hc.Stop()
+	}
 	if entry.Conn != nil {
 		// entry.Conn may be nil when connection is being tracked after unsuccessful dial().
 		_ = entry.Conn.Close(ctx)
 	}
+	c.emit(Event{Kind: EventRemoved, Endpoint: endpoint, OldState: entry.Conn.Runtime().GetState()})
 	onDone(entry.Conn.Runtime().GetState())
 }
 
@@ -297,7 +437,9 @@ func (c *cluster) Pessimize(ctx context.Context, endpoint endpoint.Endpoint) (er
 	if !c.balancer.Contains(entry.Handle) {
 		return fmt.Errorf("cluster: pessimize failed: %w", balancer.ErrUnknownBalancerElement)
 	}
+	oldState := entry.Conn.Runtime().GetState()
 	entry.Conn.Runtime().SetState(ctx, entry.Conn.Endpoint(), state.Banned)
+	c.emit(Event{Kind: EventStateChanged, Endpoint: endpoint, OldState: oldState, NewState: state.Banned})
 	if c.explorer != nil {
 		// count ratio (banned/all)
 		online := 0
@@ -0,0 +1,32 @@
+package cluster
+
+import (
+	"reflect"
+	"testing"
+
+	public "github.com/ydb-platform/ydb-go-sdk/v3/cluster"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestNewAppliesBalancerModeOption(t *testing.T) {
+	roundRobin := New(trace.Driver{}, nil, 0).(*cluster)
+	random := New(trace.Driver{}, nil, 0, public.WithBalancerMode(public.BalancerModeRandom)).(*cluster)
+	latency := New(trace.Driver{}, nil, 0, public.WithBalancerMode(public.BalancerModeLatency)).(*cluster)
+
+	types := []reflect.Type{
+		reflect.TypeOf(roundRobin.balancer),
+		reflect.TypeOf(random.balancer),
+		reflect.TypeOf(latency.balancer),
+	}
+	for i := range types {
+		for j := i + 1; j < len(types); j++ {
+			if types[i] == types[j] {
+				t.Fatalf("balancer types %v and %v should differ for distinct BalancerModes", types[i], types[j])
+			}
+		}
+	}
+
+	if _, ok := latency.balancer.(interface{ Stop() }); !ok {
+		t.Fatalf("BalancerModeLatency's balancer should expose Stop() to terminate its decay goroutine")
+	}
+}
@@ -0,0 +1,94 @@
+package cluster
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/cluster/stats/state"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// EventKind identifies the kind of cluster membership or health change
+// carried by an Event.
+type EventKind int
+
+const (
+	EventAdded EventKind = iota
+	EventRemoved
+	EventUpdated
+	EventStateChanged
+)
+
+// Event describes a single cluster membership or health change, delivered
+// to subscribers registered via Cluster.Subscribe. Higher layers (session
+// pools, table client) use it to react to node removal immediately,
+// instead of only learning of dead nodes lazily on the next Get.
+type Event struct {
+	Kind     EventKind
+	Endpoint endpoint.Endpoint
+	OldState state.State
+	NewState state.State
+}
+
+type eventSubscriber struct {
+	ch      chan<- Event
+	dropped uint64
+}
+
+// eventBus fans Events out to subscribers registered via Cluster.Subscribe.
+// Delivery is best-effort: a subscriber whose channel is full has the
+// event dropped rather than blocking the cluster.
+type eventBus struct {
+	mu   sync.RWMutex
+	subs map[*eventSubscriber]struct{}
+}
+
+func newEventBus() *eventBus {
+	return &eventBus{subs: make(map[*eventSubscriber]struct{})}
+}
+
+func (b *eventBus) subscribe(ch chan<- Event) (unsubscribe func()) {
+	sub := &eventSubscriber{ch: ch}
+
+	b.mu.Lock()
+	b.subs[sub] = struct{}{}
+	b.mu.Unlock()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subs, sub)
+			b.mu.Unlock()
+		})
+	}
+}
+
+func (b *eventBus) emit(t trace.Driver, ev Event) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	for sub := range b.subs {
+		select {
+		case sub.ch <- ev:
+		default:
+			dropped := atomic.AddUint64(&sub.dropped, 1)
+			trace.DriverOnClusterEventDropped(t, ev.Endpoint, dropped)
+		}
+	}
+}
+
+// Subscribe registers ch to receive cluster membership and health events
+// (Added, Removed, Updated, StateChanged). ch must be buffered by the
+// caller; a subscriber that does not keep up has events dropped rather
+// than blocking the cluster, with the drop count surfaced via
+// trace.Driver. The returned func removes the subscription and is safe to
+// call more than once.
+func (c *cluster) Subscribe(ch chan<- Event) (unsubscribe func()) {
+	return c.events.subscribe(ch)
+}
+
+func (c *cluster) emit(ev Event) {
+	c.events.emit(c.trace, ev)
+}
@@ -0,0 +1,69 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/endpoint"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+func TestEventBusDeliversToSubscriber(t *testing.T) {
+	b := newEventBus()
+	ch := make(chan Event, 1)
+	unsubscribe := b.subscribe(ch)
+	defer unsubscribe()
+
+	ev := Event{Kind: EventAdded, Endpoint: endpoint.Endpoint{ID: 1}}
+	b.emit(trace.Driver{}, ev)
+
+	select {
+	case got := <-ch:
+		if got != ev {
+			t.Fatalf("got %+v, want %+v", got, ev)
+		}
+	default:
+		t.Fatal("expected event to be delivered")
+	}
+}
+
+func TestEventBusDropsOnFullChannelAndReportsDropped(t *testing.T) {
+	b := newEventBus()
+	ch := make(chan Event) // unbuffered: first emit without a receiver is already full
+	unsubscribe := b.subscribe(ch)
+	defer unsubscribe()
+
+	var gotEndpoint endpoint.Endpoint
+	var gotDropped uint64
+	tr := trace.Driver{
+		OnClusterEventDropped: func(ep endpoint.Endpoint, dropped uint64) {
+			gotEndpoint = ep
+			gotDropped = dropped
+		},
+	}
+
+	ev := Event{Kind: EventAdded, Endpoint: endpoint.Endpoint{ID: 7}}
+	b.emit(tr, ev)
+	b.emit(tr, ev)
+
+	if gotEndpoint != ev.Endpoint {
+		t.Fatalf("OnClusterEventDropped endpoint = %+v, want %+v", gotEndpoint, ev.Endpoint)
+	}
+	if gotDropped != 2 {
+		t.Fatalf("dropped count = %d, want 2", gotDropped)
+	}
+}
+
+func TestEventBusUnsubscribeStopsDelivery(t *testing.T) {
+	b := newEventBus()
+	ch := make(chan Event, 1)
+	unsubscribe := b.subscribe(ch)
+	unsubscribe()
+
+	b.emit(trace.Driver{}, Event{Kind: EventRemoved})
+
+	select {
+	case ev := <-ch:
+		t.Fatalf("unexpected event after unsubscribe: %+v", ev)
+	default:
+	}
+}
@@ -0,0 +1,154 @@
+package cluster
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/cluster/stats/state"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/trace"
+)
+
+// HealthCheckConfig configures the background prober that keeps a
+// cluster entry's state in sync with its actual reachability, independent
+// of the discovery cadence. Probes only move entries between Online and
+// Unhealthy: a Banned entry still recovers only through discovery, and
+// Pessimize still forces a re-discovery once more than half of the
+// entries are not Online.
+type HealthCheckConfig struct {
+	// Interval between probes of a single endpoint. Defaults to 5 seconds.
+	Interval time.Duration
+	// FailuresToUnhealthy is the number of consecutive probe failures
+	// after which an Online entry is moved to state.Unhealthy. Defaults to 2.
+	FailuresToUnhealthy int
+	// SuccessesToOnline is the number of consecutive probe successes
+	// after which an Unhealthy entry is moved back to state.Online.
+	// Defaults to 3.
+	SuccessesToOnline int
+	// Probe issues a single health check against conn, e.g. a
+	// Discovery.WhoAmI call or a gRPC health/v1 check. A nil Probe
+	// disables health checking.
+	Probe func(ctx context.Context, conn conn.Conn) error
+}
+
+func (cfg HealthCheckConfig) withDefaults() HealthCheckConfig {
+	if cfg.Interval <= 0 {
+		cfg.Interval = 5 * time.Second
+	}
+	if cfg.FailuresToUnhealthy <= 0 {
+		cfg.FailuresToUnhealthy = 2
+	}
+	if cfg.SuccessesToOnline <= 0 {
+		cfg.SuccessesToOnline = 3
+	}
+	return cfg
+}
+
+// WithHealthCheck enables a background prober for endpoints inserted into
+// the cluster. Entries then cycle between Online and Unhealthy purely on
+// consecutive probe outcomes; Banned is still driven by Pessimize and
+// discovery as before. The balancer skips both Unhealthy and Banned
+// entries in Get.
+func WithHealthCheck(cfg HealthCheckConfig) option {
+	return func(options *optionsHolder) {
+		options.healthCheck = cfg.withDefaults()
+	}
+}
+
+// healthChecker probes a single endpoint's connection on a fixed interval
+// and transitions it between Online and Unhealthy based on consecutive
+// probe outcomes.
+type healthChecker struct {
+	cfg          HealthCheckConfig
+	trace        trace.Driver
+	conn         conn.Conn
+	onTransition func(old, next state.State)
+
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func startHealthChecker(
+	t trace.Driver,
+	c conn.Conn,
+	cfg HealthCheckConfig,
+	onTransition func(old, next state.State),
+) *healthChecker {
+	hc := &healthChecker{
+		cfg:          cfg,
+		trace:        t,
+		conn:         c,
+		onTransition: onTransition,
+		stop:         make(chan struct{}),
+	}
+	hc.wg.Add(1)
+	go hc.run()
+	return hc
+}
+
+func (hc *healthChecker) run() {
+	defer hc.wg.Done()
+
+	ticker := time.NewTicker(hc.cfg.Interval)
+	defer ticker.Stop()
+
+	var failures, successes int
+	for {
+		select {
+		case <-hc.stop:
+			return
+		case <-ticker.C:
+		}
+
+		onDone := trace.DriverOnClusterHealthCheck(hc.trace, hc.conn.Endpoint())
+
+		ctx, cancel := context.WithTimeout(context.Background(), hc.cfg.Interval)
+		err := hc.cfg.Probe(ctx, hc.conn)
+		cancel()
+
+		cur := hc.conn.Runtime().GetState()
+		failures, successes = nextFailureCounts(err, failures, successes)
+		if next, ok := nextHealthState(cur, err == nil, failures, successes, hc.cfg); ok {
+			hc.transition(next)
+		}
+		onDone(err, hc.conn.Runtime().GetState())
+	}
+}
+
+// nextFailureCounts updates the consecutive failure/success counters for
+// the outcome of a single probe.
+func nextFailureCounts(err error, failures, successes int) (nextFailures, nextSuccesses int) {
+	if err != nil {
+		return failures + 1, 0
+	}
+	return 0, successes + 1
+}
+
+// nextHealthState decides whether cur should transition given the updated
+// consecutive failure/success counts, per cfg's thresholds. It reports
+// ok=false when no transition is due.
+func nextHealthState(cur state.State, success bool, failures, successes int, cfg HealthCheckConfig) (next state.State, ok bool) {
+	switch {
+	case !success && cur == state.Online && failures >= cfg.FailuresToUnhealthy:
+		return state.Unhealthy, true
+	case success && cur == state.Unhealthy && successes >= cfg.SuccessesToOnline:
+		return state.Online, true
+	default:
+		return cur, false
+	}
+}
+
+func (hc *healthChecker) transition(next state.State) {
+	old := hc.conn.Runtime().GetState()
+	hc.conn.Runtime().SetState(context.Background(), hc.conn.Endpoint(), next)
+	if hc.onTransition != nil {
+		hc.onTransition(old, next)
+	}
+}
+
+// Stop terminates the prober goroutine and waits for it to exit.
+func (hc *healthChecker) Stop() {
+	close(hc.stop)
+	hc.wg.Wait()
+}
@@ -0,0 +1,54 @@
+package cluster
+
+import (
+	"testing"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/cluster/stats/state"
+)
+
+func TestNextFailureCounts(t *testing.T) {
+	if f, s := nextFailureCounts(nil, 2, 0); f != 0 || s != 1 {
+		t.Fatalf("nextFailureCounts(nil, 2, 0) = (%d, %d), want (0, 1)", f, s)
+	}
+	if f, s := nextFailureCounts(errFake, 0, 2); f != 1 || s != 0 {
+		t.Fatalf("nextFailureCounts(err, 0, 2) = (%d, %d), want (1, 0)", f, s)
+	}
+}
+
+func TestNextHealthStateOnlineToUnhealthy(t *testing.T) {
+	cfg := HealthCheckConfig{FailuresToUnhealthy: 2, SuccessesToOnline: 3}
+
+	if _, ok := nextHealthState(state.Online, false, 1, 0, cfg); ok {
+		t.Fatalf("expected no transition before reaching FailuresToUnhealthy")
+	}
+	next, ok := nextHealthState(state.Online, false, 2, 0, cfg)
+	if !ok || next != state.Unhealthy {
+		t.Fatalf("nextHealthState at threshold = (%v, %v), want (Unhealthy, true)", next, ok)
+	}
+}
+
+func TestNextHealthStateUnhealthyToOnline(t *testing.T) {
+	cfg := HealthCheckConfig{FailuresToUnhealthy: 2, SuccessesToOnline: 3}
+
+	if _, ok := nextHealthState(state.Unhealthy, true, 0, 2, cfg); ok {
+		t.Fatalf("expected no transition before reaching SuccessesToOnline")
+	}
+	next, ok := nextHealthState(state.Unhealthy, true, 0, 3, cfg)
+	if !ok || next != state.Online {
+		t.Fatalf("nextHealthState at threshold = (%v, %v), want (Online, true)", next, ok)
+	}
+}
+
+func TestNextHealthStateIgnoresBanned(t *testing.T) {
+	cfg := HealthCheckConfig{FailuresToUnhealthy: 1, SuccessesToOnline: 1}
+
+	if _, ok := nextHealthState(state.Banned, false, 5, 0, cfg); ok {
+		t.Fatalf("health check must not move a Banned entry; Pessimize/discovery own that transition")
+	}
+}
+
+var errFake = &fakeError{}
+
+type fakeError struct{}
+
+func (*fakeError) Error() string { return "fake" }
@@ -0,0 +1,62 @@
+package cluster
+
+import (
+	"context"
+	"testing"
+
+	"google.golang.org/grpc"
+
+	public "github.com/ydb-platform/ydb-go-sdk/v3/cluster"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/endpoint"
+)
+
+type fakeConn struct {
+	ep endpoint.Endpoint
+}
+
+func (c *fakeConn) Endpoint() endpoint.Endpoint { return c.ep }
+func (c *fakeConn) Runtime() *conn.Runtime      { return conn.NewRuntime() }
+func (c *fakeConn) Invoke(context.Context, string, interface{}, interface{}, ...grpc.CallOption) error {
+	return nil
+}
+func (c *fakeConn) Close(context.Context) error { return nil }
+
+var _ conn.Conn = (*fakeConn)(nil)
+
+func TestPickByPreferenceRequireLeader(t *testing.T) {
+	leader := &fakeConn{ep: endpoint.Endpoint{ID: 1, Role: endpoint.RoleLeader}}
+	follower := &fakeConn{ep: endpoint.Endpoint{ID: 2, Role: endpoint.RoleFollower}}
+
+	got := pickByPreference([]conn.Conn{follower, leader}, public.RequireLeader)
+	if got != leader {
+		t.Fatalf("pickByPreference(RequireLeader) = %v, want leader", got)
+	}
+}
+
+func TestPickByPreferenceRequireLeaderNoneAvailable(t *testing.T) {
+	follower := &fakeConn{ep: endpoint.Endpoint{ID: 2, Role: endpoint.RoleFollower}}
+
+	if got := pickByPreference([]conn.Conn{follower}, public.RequireLeader); got != nil {
+		t.Fatalf("pickByPreference(RequireLeader) = %v, want nil when no leader is available", got)
+	}
+}
+
+func TestPickByPreferenceFollowerFallsBackToLeader(t *testing.T) {
+	leader := &fakeConn{ep: endpoint.Endpoint{ID: 1, Role: endpoint.RoleLeader}}
+
+	got := pickByPreference([]conn.Conn{leader}, public.PreferFollower)
+	if got != leader {
+		t.Fatalf("pickByPreference(PreferFollower) = %v, want the leader as a fallback", got)
+	}
+}
+
+func TestPickByPreferenceLocalDC(t *testing.T) {
+	remote := &fakeConn{ep: endpoint.Endpoint{ID: 1, Local: false}}
+	local := &fakeConn{ep: endpoint.Endpoint{ID: 2, Local: true}}
+
+	got := pickByPreference([]conn.Conn{remote, local}, public.PreferLocalDC)
+	if got != local {
+		t.Fatalf("pickByPreference(PreferLocalDC) = %v, want the local conn", got)
+	}
+}
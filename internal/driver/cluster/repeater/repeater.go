@@ -0,0 +1,10 @@
+package repeater
+
+// Repeater runs a periodic task, such as cluster discovery, on its own
+// schedule.
+type Repeater interface {
+	// Force triggers an immediate run, independent of the regular interval.
+	Force()
+	// Stop terminates the periodic task.
+	Stop()
+}
@@ -0,0 +1,9 @@
+package wg
+
+// WG is the subset of *sync.WaitGroup that cluster's async call sites
+// need: a way to signal completion of an Insert/Update/Remove issued on a
+// caller's behalf. Depending on the interface rather than
+// *sync.WaitGroup directly lets callers substitute a no-op or test double.
+type WG interface {
+	Done()
+}
@@ -0,0 +1,68 @@
+package trace
+
+import (
+	"context"
+
+	"github.com/ydb-platform/ydb-go-sdk/v3/cluster/stats/state"
+	"github.com/ydb-platform/ydb-go-sdk/v3/internal/driver/cluster/balancer/conn/endpoint"
+)
+
+// Driver is a set of hooks for tracing internal driver events, such as
+// cluster membership and connection lifecycle changes. Any field left nil
+// is simply not traced.
+type Driver struct {
+	OnClusterGet          func(ctx context.Context) func(endpoint endpoint.Endpoint, err error)
+	OnClusterInsert       func(ctx context.Context, endpoint endpoint.Endpoint) func()
+	OnClusterUpdate       func(ctx context.Context, endpoint endpoint.Endpoint) func(state state.State)
+	OnClusterRemove       func(ctx context.Context, endpoint endpoint.Endpoint) func(state state.State)
+	OnClusterHealthCheck  func(endpoint endpoint.Endpoint) func(err error, state state.State)
+	OnClusterEventDropped func(endpoint endpoint.Endpoint, dropped uint64)
+}
+
+func DriverOnClusterGet(d Driver, ctx context.Context) func(endpoint endpoint.Endpoint, err error) {
+	if d.OnClusterGet == nil {
+		return func(endpoint.Endpoint, error) {}
+	}
+	return d.OnClusterGet(ctx)
+}
+
+func DriverOnClusterInsert(d Driver, ctx context.Context, ep endpoint.Endpoint) func() {
+	if d.OnClusterInsert == nil {
+		return func() {}
+	}
+	return d.OnClusterInsert(ctx, ep)
+}
+
+func DriverOnClusterUpdate(d Driver, ctx context.Context, ep endpoint.Endpoint) func(state state.State) {
+	if d.OnClusterUpdate == nil {
+		return func(state.State) {}
+	}
+	return d.OnClusterUpdate(ctx, ep)
+}
+
+func DriverOnClusterRemove(d Driver, ctx context.Context, ep endpoint.Endpoint) func(state state.State) {
+	if d.OnClusterRemove == nil {
+		return func(state.State) {}
+	}
+	return d.OnClusterRemove(ctx, ep)
+}
+
+// DriverOnClusterHealthCheck is called before a background health probe of
+// ep, and returns a function to be called with the probe's outcome.
+func DriverOnClusterHealthCheck(d Driver, ep endpoint.Endpoint) func(err error, state state.State) {
+	if d.OnClusterHealthCheck == nil {
+		return func(error, state.State) {}
+	}
+	return d.OnClusterHealthCheck(ep)
+}
+
+// DriverOnClusterEventDropped is called when a cluster.Event could not be
+// delivered to a subscriber because its channel was full. dropped is the
+// subscriber's total drop count so far. It takes ep rather than the event
+// itself since trace cannot import the cluster package that defines it.
+func DriverOnClusterEventDropped(d Driver, ep endpoint.Endpoint, dropped uint64) {
+	if d.OnClusterEventDropped == nil {
+		return
+	}
+	d.OnClusterEventDropped(ep, dropped)
+}